@@ -0,0 +1,136 @@
+package rticker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned by Group methods that look up a ticker by name when no ticker has
+// been registered under that name.
+var ErrNotFound = errors.New("rticker: ticker not found in group")
+
+// Event is a single tick reported by a Group, identifying which named ticker produced it.
+type Event struct {
+	Name string    // name the ticker was added under
+	Time time.Time // time reported by the ticker
+}
+
+// Group manages a set of named *T tickers that share a parent context, fanning all of their
+// ticks into a single merged channel. This avoids hand-rolling a select statement that grows
+// with the number of periodic jobs, such as a scheduler running many jobs at different
+// intervals.
+type Group struct {
+	ctx       context.Context    // parent context for all tickers in the group
+	ctxCancel context.CancelFunc // executed on CloseAll to cancel ctx
+	mu        sync.Mutex         // guards tickers, closed and wg.Add/wg.Wait ordering
+	tickers   map[string]*T      // tickers by name
+	closed    bool               // set under mu once CloseAll has started tearing the group down
+	out       chan Event         // merged fan-in channel
+	wg        sync.WaitGroup     // internal WaitGroup for fan-in goroutines
+	once      sync.Once          // for closing the group only one time
+}
+
+// NewGroupWithContext creates a Group whose tickers share ctx as their parent context.
+// ctx must not be nil, or a runtime panic is produced.
+func NewGroupWithContext(ctx context.Context) *Group {
+	if ctx == nil {
+		panic("rticker: nil context passed to NewGroupWithContext")
+	}
+	g := &Group{
+		tickers: make(map[string]*T),
+		out:     make(chan Event),
+	}
+	g.ctx, g.ctxCancel = context.WithCancel(ctx)
+	return g
+}
+
+// NewGroup creates a new Group.
+func NewGroup() *Group {
+	return NewGroupWithContext(context.Background())
+}
+
+// Add creates a new ticker with the given interval, registers it under name, and starts
+// fanning its ticks into C. If a ticker is already registered under name, it is replaced;
+// the previous ticker keeps running, and its ticks keep arriving on C under name, until it is
+// closed directly or by CloseAll canceling the shared context.
+// Returns nil if CloseAll has already started tearing down the group.
+// d must not be less than or equal to 0, or a runtime panic is produced.
+func (g *Group) Add(name string, d time.Duration) *T {
+	t := NewWithContext(g.ctx, d)
+
+	g.mu.Lock()
+	if g.closed {
+		g.mu.Unlock()
+		_ = t.Close()
+		return nil
+	}
+	g.tickers[name] = t
+	g.wg.Add(1)
+	g.mu.Unlock()
+
+	go g.fanIn(name, t)
+	return t
+}
+
+// Get returns the ticker registered under name, or nil if there is none.
+func (g *Group) Get(name string) *T {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.tickers[name]
+}
+
+// Reset resets the interval of the ticker registered under name.
+// Returns ErrNotFound if no ticker is registered under name.
+func (g *Group) Reset(name string, d time.Duration) error {
+	t := g.Get(name)
+	if t == nil {
+		return ErrNotFound
+	}
+	return t.Reset(d)
+}
+
+// C returns the channel that all named tickers fan their ticks into.
+func (g *Group) C() <-chan Event {
+	return g.out
+}
+
+// CloseAll cancels the group's shared context, closing every ticker added to the group, waits
+// for their fan-in goroutines to finish, and closes C exactly once.
+// Returns ErrClosed if the group is already closed.
+func (g *Group) CloseAll() error {
+	err := ErrClosed
+	g.once.Do(func() {
+		err = nil
+
+		g.mu.Lock()
+		g.closed = true
+		g.ctxCancel()
+		tickers := make([]*T, 0, len(g.tickers))
+		for _, t := range g.tickers {
+			tickers = append(tickers, t)
+		}
+		g.mu.Unlock()
+
+		for _, t := range tickers {
+			t.Wait()
+		}
+		g.wg.Wait()
+		close(g.out)
+	})
+	return err
+}
+
+// fanIn forwards ticks from t onto the group's merged channel, tagged with name. It returns
+// once t.C is closed or the group's context is canceled.
+func (g *Group) fanIn(name string, t *T) {
+	defer g.wg.Done()
+	for tm := range t.C {
+		select {
+		case g.out <- Event{Name: name, Time: tm}:
+		case <-g.ctx.Done():
+			return
+		}
+	}
+}
@@ -5,53 +5,139 @@ package rticker
 import (
 	"context"
 	"errors"
+	"math/rand"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 var ErrClosed = errors.New("ticker already closed")
 
-// T is a ticker that wraps time.Timer and allows resetting the interval.
+// T is a ticker that wraps a Ticker (by default a time.Timer) and allows resetting the interval.
 type T struct {
 	C         <-chan time.Time   // read-only ticker channel
 	cInternal chan time.Time     // internal channel to send ticks on
 	wg        sync.WaitGroup     // internal WaitGroup for ticker loop
-	interval  time.Duration      // ticker duration
-	timer     *time.Timer        // internal timer
+	interval  time.Duration      // ticker duration (the configured base, when jittered)
+	jitterMin time.Duration      // lower bound added to interval when jittered
+	jitterMax time.Duration      // upper bound added to interval when jittered
+	rng       *rand.Rand         // per-ticker random source, used only when jittered
+	maxTicks  uint64             // if non-zero, the ticker auto-closes after this many ticks
+	ticks     uint64             // number of ticks delivered so far, accessed atomically
+	throttled bool               // if true, ticks are dropped instead of blocking a slow consumer
+	dropped   uint64             // number of ticks dropped so far, accessed atomically
+	maker     TickerMaker        // constructs the underlying Ticker
+	ticker    Ticker             // underlying Ticker
 	resetCh   chan time.Duration // channel to request a reset
 	ctx       context.Context    // context for cancellation
 	ctxCancel context.CancelFunc // executed on Close to cancel ctx
 	once      sync.Once          // for closing the ticker only one time
 }
 
-// NewWithContext creates a resettable t (ticker) with a context.
-// ctx must not be nil, or a runtime panic is produced.
-// d must not be less than or equal to 0, or a runtime panic is produced.
-func NewWithContext(ctx context.Context, d time.Duration) *T {
+// newT is the shared constructor behind all of the package's New* functions.
+func newT(ctx context.Context, d time.Duration, maker TickerMaker, jitterMin, jitterMax time.Duration, maxTicks uint64, throttled bool) *T {
 	if ctx == nil {
-		panic("rticker: nil context passed to NewWithContext")
+		panic("rticker: nil context passed to constructor")
 	}
 	if d <= 0 {
 		panic("rticker: negative or 0 duration")
 	}
+	if maker == nil {
+		panic("rticker: nil TickerMaker passed to constructor")
+	}
 	timeChan := make(chan time.Time)
 	rt := &T{
 		C:         timeChan,
 		cInternal: timeChan,
 		interval:  d,
+		jitterMin: jitterMin,
+		jitterMax: jitterMax,
+		maxTicks:  maxTicks,
+		throttled: throttled,
+		maker:     maker,
 		resetCh:   make(chan time.Duration),
 	}
+	if jitterMax > jitterMin {
+		rt.rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
 	rt.ctx, rt.ctxCancel = context.WithCancel(ctx)
 	rt.start()
 	return rt
 }
 
+// NewWithContext creates a resettable t (ticker) with a context.
+// ctx must not be nil, or a runtime panic is produced.
+// d must not be less than or equal to 0, or a runtime panic is produced.
+func NewWithContext(ctx context.Context, d time.Duration) *T {
+	return newT(ctx, d, RealTickerMaker, 0, 0, 0, false)
+}
+
 // New creates a new T (ticker).
 // d must not be less than or equal to 0, or a runtime panic is produced.
 func New(d time.Duration) *T {
 	return NewWithContext(context.Background(), d)
 }
 
+// NewWithTickerMaker creates a resettable t (ticker) with a context, driven by the Ticker that
+// maker produces instead of a real time.Timer. This is primarily useful in tests, paired with a
+// TickerMaker returned by NewLogicalTickerMaker, to step T forward without touching wall time.
+// ctx must not be nil, or a runtime panic is produced.
+// d must not be less than or equal to 0, or a runtime panic is produced.
+// maker must not be nil, or a runtime panic is produced.
+func NewWithTickerMaker(ctx context.Context, d time.Duration, maker TickerMaker) *T {
+	return newT(ctx, d, maker, 0, 0, 0, false)
+}
+
+// NewJitteredWithContext creates a resettable t (ticker) with a context, whose fire delay is
+// randomized after every tick instead of staying fixed at base. The first tick fires after base;
+// each later delay is chosen uniformly from base+jitterMin up to, but not including,
+// base+jitterMax. This spreads out ticks across
+// many goroutines that would otherwise fire in lockstep, such as retry loops or registry pollers.
+// ctx must not be nil, or a runtime panic is produced.
+// base must not be less than or equal to 0, or a runtime panic is produced.
+// jitterMax must be greater than jitterMin, or a runtime panic is produced.
+func NewJitteredWithContext(ctx context.Context, base, jitterMin, jitterMax time.Duration) *T {
+	if jitterMax <= jitterMin {
+		panic("rticker: jitterMax must be greater than jitterMin")
+	}
+	return newT(ctx, base, RealTickerMaker, jitterMin, jitterMax, 0, false)
+}
+
+// NewJittered creates a new jittered T (ticker). See NewJitteredWithContext for details.
+func NewJittered(base, jitterMin, jitterMax time.Duration) *T {
+	return NewJitteredWithContext(context.Background(), base, jitterMin, jitterMax)
+}
+
+// NewNWithContext creates a resettable t (ticker) with a context that auto-closes after
+// delivering n ticks. A n of 0 means unbounded, matching NewWithContext.
+// ctx must not be nil, or a runtime panic is produced.
+// d must not be less than or equal to 0, or a runtime panic is produced.
+func NewNWithContext(ctx context.Context, d time.Duration, n uint64) *T {
+	return newT(ctx, d, RealTickerMaker, 0, 0, n, false)
+}
+
+// NewN creates a new T (ticker) that auto-closes after delivering n ticks. This is useful for
+// a bounded number of retries or health probes, without an external counter goroutine racing
+// with Close.
+func NewN(d time.Duration, n uint64) *T {
+	return NewNWithContext(context.Background(), d, n)
+}
+
+// NewThrottledWithContext creates a resettable t (ticker) with a context whose internal send
+// never blocks. If the consumer isn't ready to receive when a tick fires, the tick is dropped
+// and counted in Dropped, and the timer is reset immediately so ticks continue on schedule
+// rather than stretching the interval to match a slow consumer.
+// ctx must not be nil, or a runtime panic is produced.
+// d must not be less than or equal to 0, or a runtime panic is produced.
+func NewThrottledWithContext(ctx context.Context, d time.Duration) *T {
+	return newT(ctx, d, RealTickerMaker, 0, 0, 0, true)
+}
+
+// NewThrottled creates a new throttled T (ticker). See NewThrottledWithContext for details.
+func NewThrottled(d time.Duration) *T {
+	return NewThrottledWithContext(context.Background(), d)
+}
+
 // Reset resets the internal timer with the given interval.
 // Returns ErrClosed if the ticker is closed.
 func (rt *T) Reset(d time.Duration) error {
@@ -78,7 +164,7 @@ func (rt *T) Close() error {
 		rt.ctxCancel()
 		rt.Wait()
 		close(rt.cInternal)
-		rt.emptyTimer()
+		_ = rt.ticker.Close()
 	})
 	return err
 }
@@ -98,10 +184,29 @@ func (rt *T) Wait() {
 	rt.wg.Wait()
 }
 
+// SetMaxTicks sets the number of ticks after which the ticker auto-closes. A n of 0 means
+// unbounded. It is safe to call at any time, including mid-stream: the handler goroutine
+// reloads maxTicks on every tick, so setting it to a value at or below the current Ticks()
+// closes the ticker on its very next tick.
+func (rt *T) SetMaxTicks(n uint64) {
+	atomic.StoreUint64(&rt.maxTicks, n)
+}
+
+// Ticks returns the number of ticks delivered so far.
+func (rt *T) Ticks() uint64 {
+	return atomic.LoadUint64(&rt.ticks)
+}
+
+// Dropped returns the number of ticks dropped so far because the consumer wasn't ready. It is
+// only ever non-zero for a throttled ticker created with NewThrottled or NewThrottledWithContext.
+func (rt *T) Dropped() uint64 {
+	return atomic.LoadUint64(&rt.dropped)
+}
+
 // start starts the ticker. For internal use only.
 func (rt *T) start() {
 	rt.wg.Add(1)
-	rt.timer = time.NewTimer(rt.interval)
+	rt.ticker = rt.maker(rt.ctx, rt.interval)
 	go rt.handler()
 }
 
@@ -115,12 +220,13 @@ func (rt *T) handler() {
 		case <-rt.ctx.Done():
 			return
 		case d := <-rt.resetCh:
-			rt.emptyTimer()
 			if d > 0 {
 				rt.interval = d
-				rt.timer.Reset(d)
+				_ = rt.ticker.Reset(d)
+			} else {
+				_ = rt.ticker.Stop()
 			}
-		case t := <-rt.timer.C:
+		case t := <-rt.ticker.Chan():
 			if !rt.sendAndRestart(t) {
 				return
 			}
@@ -128,24 +234,47 @@ func (rt *T) handler() {
 	}
 }
 
-// emptyTimer stops and empties the internal timer.
-func (rt *T) emptyTimer() {
-	if !rt.timer.Stop() {
+// sendAndRestart sends t on the ticker channel and restarts the internal timer.
+// Returns true if restarted, false if not.
+func (rt *T) sendAndRestart(t time.Time) bool {
+	if rt.throttled {
 		select {
-		case <-rt.timer.C:
+		case <-rt.ctx.Done():
+			return false // context canceled
+		case rt.cInternal <- t:
+			return rt.afterTick()
 		default:
+			atomic.AddUint64(&rt.dropped, 1)
+			_ = rt.ticker.Reset(rt.nextInterval())
+			return true // timer reset, tick dropped
 		}
 	}
-}
 
-// restartTimer restarts the internal timer.
-// Returns true if restarted, false if not.
-func (rt *T) sendAndRestart(t time.Time) bool {
 	select {
 	case <-rt.ctx.Done():
 		return false // context canceled
 	case rt.cInternal <- t:
-		rt.timer.Reset(rt.interval)
-		return true // timer reset
+		return rt.afterTick()
+	}
+}
+
+// afterTick accounts for a delivered tick and reschedules the timer.
+// Returns true if the ticker should keep running, false if it should now close.
+func (rt *T) afterTick() bool {
+	ticks := atomic.AddUint64(&rt.ticks, 1)
+	if max := atomic.LoadUint64(&rt.maxTicks); max > 0 && ticks >= max {
+		return false // max ticks reached, let the handler close the ticker
+	}
+	_ = rt.ticker.Reset(rt.nextInterval())
+	return true
+}
+
+// nextInterval returns the duration to wait until the next tick. For a plain ticker, this is
+// just the configured interval. For a jittered ticker, it's the interval plus a uniformly random
+// offset within [jitterMin, jitterMax), recomputed on every tick.
+func (rt *T) nextInterval() time.Duration {
+	if rt.rng == nil || rt.jitterMax <= rt.jitterMin {
+		return rt.interval
 	}
+	return rt.interval + time.Duration(rt.rng.Int63n(int64(rt.jitterMax-rt.jitterMin))) + rt.jitterMin
 }
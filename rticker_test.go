@@ -134,6 +134,214 @@ func TestStopMultipleTimesIsSafe(t *testing.T) {
 	t.Log("No panic occurred, test successful.")
 }
 
+func TestJitteredTicksWithinWindow(t *testing.T) {
+	ticker := rticker.NewJittered(50*time.Millisecond, 10*time.Millisecond, 40*time.Millisecond)
+	defer func() {
+		_ = ticker.Close()
+	}()
+
+	// The first tick fires after the unjittered base interval; jitter applies starting
+	// with the second tick, once sendAndRestart has computed a jittered next interval.
+	select {
+	case <-ticker.C:
+	case <-time.After(200 * time.Millisecond):
+		t.Fatal("expected first tick, got timeout")
+	}
+
+	for i := 0; i < 2; i++ {
+		start := time.Now()
+		select {
+		case <-ticker.C:
+		case <-time.After(200 * time.Millisecond):
+			t.Fatal("expected tick, got timeout")
+		}
+		elapsed := time.Since(start)
+		if elapsed < 55*time.Millisecond || elapsed > 150*time.Millisecond {
+			t.Fatalf("tick %d fired outside jitter window: %v", i, elapsed)
+		}
+	}
+}
+
+func TestNewJitteredPanicsOnBadWindow(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic when jitterMax <= jitterMin")
+		}
+	}()
+	rticker.NewJittered(50*time.Millisecond, 10*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestLogicalTickerMakerStepsDeterministically(t *testing.T) {
+	source := make(chan time.Time)
+	ticker := rticker.NewWithTickerMaker(context.Background(), time.Hour, rticker.NewLogicalTickerMaker(source))
+	defer func() {
+		_ = ticker.Close()
+	}()
+
+	for i := 0; i < 3; i++ {
+		want := time.Unix(int64(i), 0)
+		source <- want
+		select {
+		case got := <-ticker.C:
+			if !got.Equal(want) {
+				t.Fatalf("tick %d: got %v, want %v", i, got, want)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("expected tick, got timeout")
+		}
+	}
+}
+
+func TestNewNClosesAfterMaxTicks(t *testing.T) {
+	ticker := rticker.NewN(10*time.Millisecond, 3)
+
+	count := 0
+	for range ticker.C {
+		count++
+	}
+
+	if count != 3 {
+		t.Fatalf("expected 3 ticks, got %d", count)
+	}
+	if got := ticker.Ticks(); got != 3 {
+		t.Fatalf("expected Ticks() == 3, got %d", got)
+	}
+	if !ticker.IsClosed() {
+		t.Fatal("expected ticker to be closed after max ticks reached")
+	}
+}
+
+func TestSetMaxTicksBeforeFirstTick(t *testing.T) {
+	ticker := rticker.New(10 * time.Millisecond)
+	ticker.SetMaxTicks(2)
+
+	count := 0
+	for range ticker.C {
+		count++
+	}
+
+	if count != 2 {
+		t.Fatalf("expected 2 ticks, got %d", count)
+	}
+}
+
+func TestThrottledDropsWhenConsumerSlow(t *testing.T) {
+	ticker := rticker.NewThrottled(20 * time.Millisecond)
+	defer func() {
+		_ = ticker.Close()
+	}()
+
+	// Don't read from ticker.C at all for a while, so several ticks fire and get dropped.
+	time.Sleep(150 * time.Millisecond)
+
+	select {
+	case <-ticker.C:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("expected at least one tick to eventually be received")
+	}
+
+	if ticker.Dropped() == 0 {
+		t.Fatal("expected some ticks to have been dropped")
+	}
+}
+
+func TestGroupFansInNamedTicks(t *testing.T) {
+	group := rticker.NewGroup()
+	defer func() {
+		_ = group.CloseAll()
+	}()
+
+	group.Add("fast", 20*time.Millisecond)
+	group.Add("slow", 200*time.Millisecond)
+
+	seen := make(map[string]bool)
+	for len(seen) < 2 {
+		select {
+		case ev := <-group.C():
+			seen[ev.Name] = true
+		case <-time.After(500 * time.Millisecond):
+			t.Fatalf("timed out waiting for events from both tickers, got: %v", seen)
+		}
+	}
+}
+
+func TestGroupResetUnknownTicker(t *testing.T) {
+	group := rticker.NewGroup()
+	defer func() {
+		_ = group.CloseAll()
+	}()
+
+	err := group.Reset("nope", 10*time.Millisecond)
+	if err != rticker.ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestGroupCloseAllClosesChannel(t *testing.T) {
+	group := rticker.NewGroup()
+	group.Add("a", 10*time.Millisecond)
+	group.Add("b", 10*time.Millisecond)
+
+	if err := group.CloseAll(); err != nil {
+		t.Fatalf("unexpected error on CloseAll: %v", err)
+	}
+
+	select {
+	case _, ok := <-group.C():
+		if ok {
+			t.Fatal("expected C() to be closed after CloseAll")
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("expected C() to be closed, got timeout")
+	}
+
+	if err := group.CloseAll(); err == nil {
+		t.Fatal("expected error on second CloseAll")
+	}
+}
+
+func TestGroupAddRacesCloseAll(t *testing.T) {
+	group := rticker.NewGroup()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		group.Add("racer", time.Millisecond)
+	}()
+	go func() {
+		defer wg.Done()
+		_ = group.CloseAll()
+	}()
+	wg.Wait()
+}
+
+func TestGroupAddPanicDoesNotDeadlockMutex(t *testing.T) {
+	group := rticker.NewGroup()
+	defer func() {
+		_ = group.CloseAll()
+	}()
+
+	func() {
+		defer func() {
+			_ = recover()
+		}()
+		group.Add("bad", 0)
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		group.Add("good", 5*time.Millisecond)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Add deadlocked after a panicking Add left the group's mutex locked")
+	}
+}
+
 func TestTickerWithContextCancel(t *testing.T) {
 	var mu sync.Mutex
 	var wg sync.WaitGroup
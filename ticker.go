@@ -0,0 +1,113 @@
+package rticker
+
+import (
+	"context"
+	"time"
+)
+
+// Ticker is the minimal interface T needs from its underlying clock source. It is implemented
+// by realTicker (backed by time.Timer) and by the logical ticker returned from
+// NewLogicalTickerMaker, allowing T to be driven by a simulated clock in tests.
+type Ticker interface {
+	// Chan returns the channel on which the next tick is delivered.
+	Chan() <-chan time.Time
+	// Reset reschedules the next tick to fire after d.
+	Reset(d time.Duration) error
+	// Stop prevents the next tick from firing, without releasing any other resources.
+	Stop() error
+	// Close releases any resources held by the ticker. It need not be safe to call twice.
+	Close() error
+	// IsClosed reports whether Close has been called.
+	IsClosed() bool
+}
+
+// TickerMaker constructs a Ticker for T to drive itself with, given the context T was created
+// with and its initial interval. NewWithContext and friends use RealTickerMaker by default;
+// NewWithTickerMaker accepts any TickerMaker, such as one returned by NewLogicalTickerMaker.
+type TickerMaker func(ctx context.Context, d time.Duration) Ticker
+
+// realTicker is the default Ticker, backed by a time.Timer.
+type realTicker struct {
+	timer  *time.Timer
+	closed bool
+}
+
+// RealTickerMaker is the TickerMaker used by New, NewWithContext, NewJittered and
+// NewJitteredWithContext. It produces a Ticker backed by a real time.Timer.
+func RealTickerMaker(_ context.Context, d time.Duration) Ticker {
+	return &realTicker{timer: time.NewTimer(d)}
+}
+
+func (rtk *realTicker) Chan() <-chan time.Time {
+	return rtk.timer.C
+}
+
+func (rtk *realTicker) Reset(d time.Duration) error {
+	rtk.drain()
+	rtk.timer.Reset(d)
+	return nil
+}
+
+func (rtk *realTicker) Stop() error {
+	rtk.drain()
+	return nil
+}
+
+func (rtk *realTicker) Close() error {
+	rtk.drain()
+	rtk.closed = true
+	return nil
+}
+
+func (rtk *realTicker) IsClosed() bool {
+	return rtk.closed
+}
+
+// drain stops and empties the internal timer, per the pattern documented by time.Timer.Stop.
+func (rtk *realTicker) drain() {
+	if !rtk.timer.Stop() {
+		select {
+		case <-rtk.timer.C:
+		default:
+		}
+	}
+}
+
+// logicalTicker is a Ticker whose Chan is an externally supplied channel. Nothing in the
+// ticker loop ever writes to it; a test drives time forward by sending on the same channel
+// it passed to NewLogicalTickerMaker. Reset and Stop are no-ops, since the test, not the
+// ticker, decides when the next tick arrives.
+type logicalTicker struct {
+	source chan time.Time
+	closed bool
+}
+
+// NewLogicalTickerMaker returns a TickerMaker whose Tickers all deliver ticks from source,
+// instead of a real time.Timer. Tests can then step T forward deterministically by sending
+// values on source, rather than sleeping and hoping wall-clock timing lines up.
+func NewLogicalTickerMaker(source chan time.Time) TickerMaker {
+	return func(_ context.Context, _ time.Duration) Ticker {
+		return &logicalTicker{source: source}
+	}
+}
+
+func (lt *logicalTicker) Chan() <-chan time.Time {
+	return lt.source
+}
+
+func (lt *logicalTicker) Reset(time.Duration) error {
+	return nil
+}
+
+func (lt *logicalTicker) Stop() error {
+	return nil
+}
+
+func (lt *logicalTicker) Close() error {
+	lt.closed = true
+	return nil
+}
+
+func (lt *logicalTicker) IsClosed() bool {
+	return lt.closed
+}